@@ -152,6 +152,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		orbital.Registration{},
 		streamanalytics.Registration{},
 		search.Registration{},
+		synapse.Registration{},
 		web.Registration{},
 	}
 	services = append(services, autoRegisteredTypedServices()...)
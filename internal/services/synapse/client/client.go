@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/2019-06-01-preview/managedvirtualnetwork"
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/2020-08-01-preview/accesscontrol"
@@ -9,9 +10,13 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/sdk/2021-06-01-preview/artifacts"
+	hamiltonAuth "github.com/manicminer/hamilton-autorest/auth"
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
 )
 
 type Client struct {
+	AzureADOnlyAuthenticationsClient                  *synapse.AzureADOnlyAuthenticationsClient
 	FirewallRulesClient                               *synapse.IPFirewallRulesClient
 	IntegrationRuntimeAuthKeysClient                  *synapse.IntegrationRuntimeAuthKeysClient
 	IntegrationRuntimesClient                         *synapse.IntegrationRuntimesClient
@@ -35,9 +40,15 @@ type Client struct {
 	WorkspaceVulnerabilityAssessmentsClient           *synapse.WorkspaceManagedSQLServerVulnerabilityAssessmentsClient
 
 	synapseAuthorizer autorest.Authorizer
+	graphEndpoint     string
+	tenantId          string
+	tokenFunc         common.EndpointTokenFunc
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	azureADOnlyAuthenticationsClient := synapse.NewAzureADOnlyAuthenticationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&azureADOnlyAuthenticationsClient.Client, o.ResourceManagerAuthorizer)
+
 	firewallRuleClient := synapse.NewIPFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&firewallRuleClient.Client, o.ResourceManagerAuthorizer)
 
@@ -103,6 +114,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&workspaceVulnerabilityAssessmentsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		AzureADOnlyAuthenticationsClient:                  &azureADOnlyAuthenticationsClient,
 		FirewallRulesClient:                               &firewallRuleClient,
 		IntegrationRuntimeAuthKeysClient:                  &integrationRuntimeAuthKeysClient,
 		IntegrationRuntimesClient:                         &integrationRuntimesClient,
@@ -126,6 +138,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		WorkspaceVulnerabilityAssessmentsClient:           &workspaceVulnerabilityAssessmentsClient,
 
 		synapseAuthorizer: o.SynapseAuthorizer,
+		graphEndpoint:     o.Environment.MicrosoftGraphEndpoint,
+		tenantId:          o.TenantID,
+		tokenFunc:         o.TokenFunc,
 	}
 }
 
@@ -169,6 +184,31 @@ func (client Client) LinkedServiceClient(workspaceName, synapseEndpointSuffix st
 	return &linkedServiceClient, nil
 }
 
+// UsersClient returns a Microsoft Graph client used to look up Azure Active Directory users, for example
+// to resolve the Object ID of an AAD Admin from its User Principal Name
+func (client Client) UsersClient() (*msgraph.UsersClient, error) {
+	if client.graphEndpoint == "" || client.tokenFunc == nil {
+		return nil, fmt.Errorf("Microsoft Graph is not supported in this Azure Environment")
+	}
+
+	endpoint := strings.TrimSuffix(client.graphEndpoint, "/")
+	graphAuth, err := client.tokenFunc(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", endpoint, err)
+	}
+
+	authorizer, err := hamiltonAuth.NewAuthorizerWrapper(graphAuth)
+	if err != nil {
+		return nil, fmt.Errorf("building Microsoft Graph authorizer: %+v", err)
+	}
+
+	usersClient := msgraph.NewUsersClient(client.tenantId)
+	usersClient.BaseClient.ApiVersion = msgraph.Version10
+	usersClient.BaseClient.Authorizer = authorizer
+	usersClient.BaseClient.Endpoint = environments.ApiEndpoint(endpoint)
+	return usersClient, nil
+}
+
 func buildEndpoint(workspaceName string, synapseEndpointSuffix string) string {
 	return fmt.Sprintf("https://%s.%s", workspaceName, synapseEndpointSuffix)
 }
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WorkspaceAzureADOnlyAuthenticationId struct {
+	SubscriptionId                string
+	ResourceGroup                 string
+	WorkspaceName                 string
+	AzureADOnlyAuthenticationName string
+}
+
+func NewWorkspaceAzureADOnlyAuthenticationID(subscriptionId, resourceGroup, workspaceName, azureADOnlyAuthenticationName string) WorkspaceAzureADOnlyAuthenticationId {
+	return WorkspaceAzureADOnlyAuthenticationId{
+		SubscriptionId:                subscriptionId,
+		ResourceGroup:                 resourceGroup,
+		WorkspaceName:                 workspaceName,
+		AzureADOnlyAuthenticationName: azureADOnlyAuthenticationName,
+	}
+}
+
+func (id WorkspaceAzureADOnlyAuthenticationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Azure A D Only Authentication Name %q", id.AzureADOnlyAuthenticationName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Workspace Azure A D Only Authentication", segmentsStr)
+}
+
+func (id WorkspaceAzureADOnlyAuthenticationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Synapse/workspaces/%s/azureADOnlyAuthentications/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.AzureADOnlyAuthenticationName)
+}
+
+// WorkspaceAzureADOnlyAuthenticationID parses a WorkspaceAzureADOnlyAuthentication ID into an WorkspaceAzureADOnlyAuthenticationId struct
+func WorkspaceAzureADOnlyAuthenticationID(input string) (*WorkspaceAzureADOnlyAuthenticationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := WorkspaceAzureADOnlyAuthenticationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.AzureADOnlyAuthenticationName, err = id.PopSegment("azureADOnlyAuthentications"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -7,12 +7,26 @@ import (
 
 type Registration struct{}
 
-var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.TypedServiceRegistration                   = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+)
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/synapse"
 }
 
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		SynapseWorkspaceAADAdminResource{},
+		SynapseWorkspaceAzureADOnlyAuthenticationResource{},
+	}
+}
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Synapse"
@@ -28,7 +42,8 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_synapse_workspace": dataSourceSynapseWorkspace(),
+		"azurerm_synapse_workspace":           dataSourceSynapseWorkspace(),
+		"azurerm_synapse_workspace_aad_admin": dataSourceSynapseWorkspaceAADAdmin(),
 	}
 }
 
@@ -51,7 +66,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_synapse_sql_pool_workload_classifier":               resourceSynapseSQLPoolWorkloadClassifier(),
 		"azurerm_synapse_sql_pool_workload_group":                    resourceSynapseSQLPoolWorkloadGroup(),
 		"azurerm_synapse_workspace":                                  resourceSynapseWorkspace(),
-		"azurerm_synapse_workspace_aad_admin":                        resourceSynapseWorkspaceAADAdmin(),
 		"azurerm_synapse_workspace_extended_auditing_policy":         resourceSynapseWorkspaceExtendedAuditingPolicy(),
 		"azurerm_synapse_workspace_key":                              resourceSynapseWorkspaceKey(),
 		"azurerm_synapse_workspace_security_alert_policy":            resourceSynapseWorkspaceSecurityAlertPolicy(),
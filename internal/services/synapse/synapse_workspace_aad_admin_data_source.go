@@ -0,0 +1,75 @@
+package synapse
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceSynapseWorkspaceAADAdmin() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceSynapseWorkspaceAADAdminRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"login": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"object_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSynapseWorkspaceAADAdminRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.WorkspaceAadAdminsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewWorkspaceAADAdminID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, "activeDirectory")
+	resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("no AAD Admin is configured for Synapse Workspace %q (Resource Group %q)", id.WorkspaceName, id.ResourceGroup)
+		}
+		return fmt.Errorf("retrieving Synapse Workspace %q AAD Admin (Resource Group %q): %+v", id.WorkspaceName, id.ResourceGroup, err)
+	}
+
+	props := resp.AadAdminProperties
+	if props == nil {
+		return fmt.Errorf("no AAD Admin is configured for Synapse Workspace %q (Resource Group %q)", id.WorkspaceName, id.ResourceGroup)
+	}
+
+	d.SetId(id.ID())
+
+	return setSynapseWorkspaceAadAdminProperties(d, workspaceId.ID(), props)
+}
@@ -0,0 +1,37 @@
+package synapse_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SynapseWorkspaceAADAdminDataSource struct{}
+
+func TestAccDataSourceSynapseWorkspaceAADAdmin_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_synapse_workspace_aad_admin", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: SynapseWorkspaceAADAdminDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("login").HasValue("AzureAD Admin"),
+				check.That(data.ResourceName).Key("object_id").Exists(),
+				check.That(data.ResourceName).Key("tenant_id").Exists(),
+			),
+		},
+	})
+}
+
+func (d SynapseWorkspaceAADAdminDataSource) basic(data acceptance.TestData) string {
+	config := SynapseWorkspaceAADAdminResource{}.basic(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_synapse_workspace_aad_admin" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace_aad_admin.test.synapse_workspace_id
+}
+`, config)
+}
@@ -1,146 +1,463 @@
 package synapse
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/manicminer/hamilton/odata"
 )
 
-func resourceSynapseWorkspaceAADAdmin() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
-		Create: resourceSynapseWorkspaceAADAdminCreateUpdate,
-		Read:   resourceSynapseWorkspaceAADAdminRead,
-		Update: resourceSynapseWorkspaceAADAdminCreateUpdate,
-		Delete: resourceSynapseWorkspaceAADAdminDelete,
-
-		Timeouts: &pluginsdk.ResourceTimeout{
-			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
-			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
-			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
-			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+type SynapseWorkspaceAADAdminResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = SynapseWorkspaceAADAdminResource{}
+	_ sdk.ResourceWithCustomizeDiff = SynapseWorkspaceAADAdminResource{}
+)
+
+type SynapseWorkspaceAADAdminModel struct {
+	SynapseWorkspaceId string `tfschema:"synapse_workspace_id"`
+	Login              string `tfschema:"login"`
+	ObjectId           string `tfschema:"object_id"`
+	TenantId           string `tfschema:"tenant_id"`
+	IdentityType       string `tfschema:"identity_type"`
+	Enabled            bool   `tfschema:"enabled"`
+}
+
+const (
+	aadAdminIdentityTypeGroup            = "Group"
+	aadAdminIdentityTypeServicePrincipal = "ServicePrincipal"
+	aadAdminIdentityTypeUser             = "User"
+)
+
+func (r SynapseWorkspaceAADAdminResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"synapse_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WorkspaceID,
+		},
+
+		"login": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"object_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"tenant_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"identity_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  aadAdminIdentityTypeUser,
+			ValidateFunc: validation.StringInSlice([]string{
+				aadAdminIdentityTypeGroup,
+				aadAdminIdentityTypeServicePrincipal,
+				aadAdminIdentityTypeUser,
+			}, false),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.WorkspaceAADAdminID(id)
-			return err
-		}),
-
-		Schema: map[string]*pluginsdk.Schema{
-			"synapse_workspace_id": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validate.WorkspaceID,
-			},
-
-			"login": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
-			},
-
-			"object_id": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validation.IsUUID,
-			},
-
-			"tenant_id": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validation.IsUUID,
-			},
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 	}
 }
 
-func resourceSynapseWorkspaceAADAdminCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.WorkspaceAadAdminsClient
-	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+func (r SynapseWorkspaceAADAdminResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
 
-	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
-	if err != nil {
-		return err
+func (r SynapseWorkspaceAADAdminResource) ResourceType() string {
+	return "azurerm_synapse_workspace_aad_admin"
+}
+
+func (r SynapseWorkspaceAADAdminResource) ModelObject() interface{} {
+	return &SynapseWorkspaceAADAdminModel{}
+}
+
+func (r SynapseWorkspaceAADAdminResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WorkspaceAADAdminID
+}
+
+func (r SynapseWorkspaceAADAdminResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Synapse.WorkspaceAadAdminsClient
+
+			var model SynapseWorkspaceAADAdminModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := parse.WorkspaceID(model.SynapseWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWorkspaceAADAdminID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, "activeDirectory")
+
+			if !model.Enabled {
+				if err := r.clearAdmin(ctx, client, id); err != nil {
+					return err
+				}
+
+				metadata.SetID(id)
+				return nil
+			}
+
+			if model.ObjectId == "" {
+				objectId, err := r.objectIdFromLogin(ctx, metadata.Client.Synapse, model.IdentityType, model.Login)
+				if err != nil {
+					return err
+				}
+				model.ObjectId = objectId
+			}
+
+			if err := r.setAdmin(ctx, client, id, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
 	}
-	workspaceName := workspaceId.Name
-	workspaceResourceGroup := workspaceId.ResourceGroup
+}
 
-	aadAdmin := &synapse.WorkspaceAadAdminInfo{
-		AadAdminProperties: &synapse.AadAdminProperties{
-			TenantID:          utils.String(d.Get("tenant_id").(string)),
-			Login:             utils.String(d.Get("login").(string)),
-			AdministratorType: utils.String("ActiveDirectory"),
-			Sid:               utils.String(d.Get("object_id").(string)),
+func (r SynapseWorkspaceAADAdminResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Synapse.WorkspaceAadAdminsClient
+
+			id, err := parse.WorkspaceAADAdminID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var existing SynapseWorkspaceAADAdminModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+			if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// when the admin has been removed the API can return an empty admin rather than a 404
+			props := resp.AadAdminProperties
+			if utils.ResponseWasNotFound(resp.Response) || props == nil {
+				// `enabled` is unset when importing or for state created prior to it being introduced
+				enabled := existing.Enabled
+				if rawState := metadata.ResourceData.GetRawState(); !rawState.IsNull() && rawState.GetAttr("enabled").IsNull() {
+					enabled = true
+				}
+				if enabled {
+					return metadata.MarkAsGone(id)
+				}
+
+				// the admin has been intentionally cleared, so the remaining arguments are retained from the existing state
+				existing.SynapseWorkspaceId = parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID()
+				if existing.IdentityType == "" {
+					existing.IdentityType = aadAdminIdentityTypeUser
+				}
+				return metadata.Encode(&existing)
+			}
+
+			state := SynapseWorkspaceAADAdminModel{
+				SynapseWorkspaceId: parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+				Login:              utils.NormalizeNilableString(props.Login),
+				ObjectId:           utils.NormalizeNilableString(props.Sid),
+				TenantId:           utils.NormalizeNilableString(props.TenantID),
+				Enabled:            true,
+				// the API doesn't return the kind of principal, so this is retrieved from the existing state
+				// (and defaulted when importing)
+				IdentityType: existing.IdentityType,
+			}
+			if state.IdentityType == "" {
+				state.IdentityType = aadAdminIdentityTypeUser
+			}
+
+			return metadata.Encode(&state)
 		},
 	}
+}
+
+func (r SynapseWorkspaceAADAdminResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Synapse.WorkspaceAadAdminsClient
+
+			id, err := parse.WorkspaceAADAdminID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SynapseWorkspaceAADAdminModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !model.Enabled {
+				if metadata.ResourceData.HasChange("enabled") {
+					return r.clearAdmin(ctx, client, *id)
+				}
+				return nil
+			}
+
+			// `object_id` is Computed, so when it's omitted from the config it's only looked up again when the principal could
+			// have changed - which matches the value being marked as known after apply in CustomizeDiff
+			rd := metadata.ResourceData
+			if rd.GetRawConfig().AsValueMap()["object_id"].IsNull() && (rd.HasChange("login") || rd.HasChange("enabled")) {
+				objectId, err := r.objectIdFromLogin(ctx, metadata.Client.Synapse, model.IdentityType, model.Login)
+				if err != nil {
+					return err
+				}
+				model.ObjectId = objectId
+			}
+
+			return r.setAdmin(ctx, client, *id, model)
+		},
+	}
+}
+
+func (r SynapseWorkspaceAADAdminResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Synapse.WorkspaceAadAdminsClient
+
+			id, err := parse.WorkspaceAADAdminID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// `enabled` decodes as false for state created prior to it being introduced, so the admin is always cleared - which is
+			// harmless when it has already been cleared
+			return r.clearAdmin(ctx, client, *id)
+		},
+	}
+}
 
-	workspaceAadAdminsCreateOrUpdateFuture, err := client.CreateOrUpdate(ctx, workspaceResourceGroup, workspaceName, *aadAdmin)
+func (r SynapseWorkspaceAADAdminResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			objectIdOmitted := rd.GetRawConfig().GetAttr("object_id").IsNull()
+
+			// only users can be looked up by their User Principal Name, so this is caught at plan time rather than during the apply
+			if identityType := rd.Get("identity_type").(string); objectIdOmitted && identityType != "" && identityType != aadAdminIdentityTypeUser {
+				return fmt.Errorf("`object_id` must be specified when `identity_type` is %q", identityType)
+			}
+
+			// when `object_id` is omitted it's looked up from the `login`, so the value in state is stale once the
+			// `login` changes or the admin is re-enabled
+			if objectIdOmitted && rd.Get("enabled").(bool) && (rd.HasChange("login") || rd.HasChange("enabled")) {
+				if err := rd.SetNewComputed("object_id"); err != nil {
+					return fmt.Errorf("setting `object_id` to known after apply: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SynapseWorkspaceAADAdminResource) setAdmin(ctx context.Context, client *synapse.WorkspaceAadAdminsClient, id parse.WorkspaceAADAdminId, model SynapseWorkspaceAADAdminModel) error {
+	aadAdmin := expandSynapseWorkspaceAadAdminInfo(model.TenantId, model.Login, model.ObjectId)
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, aadAdmin)
 	if err != nil {
-		return fmt.Errorf("updating Synapse Workspace %q AAD Admin (Resource Group %q): %+v", workspaceName, workspaceResourceGroup, err)
+		return fmt.Errorf("setting %s: %+v", id, err)
 	}
 
-	if err = workspaceAadAdminsCreateOrUpdateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting on updating for Synapse Workspace %q AAD Admin (Resource Group %q): %+v", workspaceName, workspaceResourceGroup, err)
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be set: %+v", id, err)
 	}
 
-	id := parse.NewWorkspaceAADAdminID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, "activeDirectory")
-	d.SetId(id.ID())
+	// the API is eventually consistent, so the previous (or an empty) admin can be returned for a while after the update completes
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{"Pending"},
+		Target:                    []string{"Applied"},
+		Refresh:                   synapseWorkspaceAadAdminStateRefreshFunc(ctx, client, id, model),
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 2,
+		Timeout:                   time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to become consistent: %+v", id, err)
+	}
 
-	return resourceSynapseWorkspaceAADAdminRead(d, meta)
+	return nil
 }
 
-func resourceSynapseWorkspaceAADAdminRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.WorkspaceAadAdminsClient
-	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+func synapseWorkspaceAadAdminStateRefreshFunc(ctx context.Context, client *synapse.WorkspaceAadAdminsClient, id parse.WorkspaceAADAdminId, model SynapseWorkspaceAADAdminModel) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "Pending", nil
+			}
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
 
-	id, err := parse.WorkspaceAADAdminID(d.Id())
-	if err != nil {
-		return err
+		props := resp.AadAdminProperties
+		if props == nil || props.Sid == nil || props.Login == nil {
+			return resp, "Pending", nil
+		}
+
+		if !strings.EqualFold(*props.Sid, model.ObjectId) || !strings.EqualFold(*props.Login, model.Login) {
+			return resp, "Pending", nil
+		}
+
+		return resp, "Applied", nil
 	}
+}
 
-	aadAdmin, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+func (r SynapseWorkspaceAADAdminResource) clearAdmin(ctx context.Context, client *synapse.WorkspaceAadAdminsClient, id parse.WorkspaceAADAdminId) error {
+	future, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName)
 	if err != nil {
-		if !utils.ResponseWasNotFound(aadAdmin.Response) {
-			return fmt.Errorf("retrieving Synapse Workspace %q AAD Admin (Resource Group %q): %+v", id.WorkspaceName, id.ResourceGroup, err)
-		}
+		return fmt.Errorf("setting empty %s: %+v", id, err)
 	}
 
-	workspaceID := parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on setting empty %s: %+v", id, err)
+	}
 
-	d.Set("synapse_workspace_id", workspaceID.ID())
-	d.Set("login", aadAdmin.AadAdminProperties.Login)
-	d.Set("object_id", aadAdmin.AadAdminProperties.Sid)
-	d.Set("tenant_id", aadAdmin.AadAdminProperties.TenantID)
+	// as when setting the admin, the previous admin can still be returned for a while after the delete completes
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{"Pending"},
+		Target:                    []string{"Cleared"},
+		Refresh:                   synapseWorkspaceAadAdminClearedStateRefreshFunc(ctx, client, id),
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 2,
+		Timeout:                   time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be cleared: %+v", id, err)
+	}
 
 	return nil
 }
 
-func resourceSynapseWorkspaceAADAdminDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.WorkspaceAadAdminsClient
-	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+func synapseWorkspaceAadAdminClearedStateRefreshFunc(ctx context.Context, client *synapse.WorkspaceAadAdminsClient, id parse.WorkspaceAADAdminId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "Cleared", nil
+			}
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
 
-	id, err := parse.WorkspaceAADAdminID(d.Id())
+		if resp.AadAdminProperties == nil {
+			return resp, "Cleared", nil
+		}
+
+		return resp, "Pending", nil
+	}
+}
+
+// objectIdFromLogin resolves the Object ID of the Azure Active Directory user whose User Principal Name matches `login`
+func (r SynapseWorkspaceAADAdminResource) objectIdFromLogin(ctx context.Context, synapseClient *client.Client, identityType, login string) (string, error) {
+	if identityType != aadAdminIdentityTypeUser {
+		return "", fmt.Errorf("`object_id` must be specified when `identity_type` is %q", identityType)
+	}
+
+	if !strings.Contains(login, "@") {
+		return "", fmt.Errorf("`object_id` must be specified when `login` (%q) is not a User Principal Name", login)
+	}
+
+	usersClient, err := synapseClient.UsersClient()
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName)
+	filter := fmt.Sprintf("userPrincipalName eq '%s'", strings.ReplaceAll(login, "'", "''"))
+	users, _, err := usersClient.List(ctx, odata.Query{Filter: filter})
 	if err != nil {
-		return fmt.Errorf("setting empty Synapse Workspace %q AAD Admin (Resource Group %q): %+v", id.WorkspaceName, id.ResourceGroup, err)
+		return "", fmt.Errorf("listing Azure Active Directory users with the User Principal Name %q: %+v", login, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting on setting empty Synapse Workspace %q AAD Admin (Resource Group %q): %+v", id.WorkspaceName, id.ResourceGroup, err)
+	if users == nil || len(*users) == 0 {
+		return "", fmt.Errorf("no Azure Active Directory user was found with the User Principal Name %q - specify `object_id` explicitly", login)
+	}
+
+	if len(*users) > 1 {
+		return "", fmt.Errorf("found %d Azure Active Directory users with the User Principal Name %q - specify `object_id` explicitly", len(*users), login)
+	}
+
+	user := (*users)[0]
+	if user.ID == nil || *user.ID == "" {
+		return "", fmt.Errorf("the Object ID of the Azure Active Directory user with the User Principal Name %q was nil", login)
+	}
+
+	return *user.ID, nil
+}
+
+// expandSynapseWorkspaceAadAdminInfo builds the payload shared by the Workspace (`administrators`) and
+// SQL (`sqlAdministrators`) Azure Active Directory Admin endpoints. The API only accepts `ActiveDirectory` as the
+// administrator type, regardless of whether the admin is a User, Group or Service Principal.
+func expandSynapseWorkspaceAadAdminInfo(tenantId, login, objectId string) synapse.WorkspaceAadAdminInfo {
+	return synapse.WorkspaceAadAdminInfo{
+		AadAdminProperties: &synapse.AadAdminProperties{
+			TenantID:          utils.String(tenantId),
+			Login:             utils.String(login),
+			AdministratorType: utils.String("ActiveDirectory"),
+			Sid:               utils.String(objectId),
+		},
+	}
+}
+
+// setSynapseWorkspaceAadAdminProperties sets the attributes shared by the Workspace and SQL Azure Active Directory Admin
+// into the state, returning the first error encountered when setting them
+func setSynapseWorkspaceAadAdminProperties(d *pluginsdk.ResourceData, workspaceId string, props *synapse.AadAdminProperties) error {
+	if err := d.Set("synapse_workspace_id", workspaceId); err != nil {
+		return fmt.Errorf("setting `synapse_workspace_id`: %+v", err)
+	}
+	if err := d.Set("login", utils.NormalizeNilableString(props.Login)); err != nil {
+		return fmt.Errorf("setting `login`: %+v", err)
+	}
+	if err := d.Set("object_id", utils.NormalizeNilableString(props.Sid)); err != nil {
+		return fmt.Errorf("setting `object_id`: %+v", err)
+	}
+	if err := d.Set("tenant_id", utils.NormalizeNilableString(props.TenantID)); err != nil {
+		return fmt.Errorf("setting `tenant_id`: %+v", err)
 	}
 
 	return nil
@@ -3,6 +3,7 @@ package synapse_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -30,6 +31,121 @@ func TestAccSynapseWorkspaceAADAdmin_basic(t *testing.T) {
 	})
 }
 
+func TestAccSynapseWorkspaceAADAdmin_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_aad_admin", "test")
+	r := SynapseWorkspaceAADAdminResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity_type").HasValue("ServicePrincipal"),
+			),
+		},
+		data.ImportStep("identity_type"),
+	})
+}
+
+func TestAccSynapseWorkspaceAADAdmin_group(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_aad_admin", "test")
+	r := SynapseWorkspaceAADAdminResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.group(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity_type").HasValue("Group"),
+				acceptance.TestCheckResourceAttrPair(data.ResourceName, "object_id", "azuread_group.test", "object_id"),
+			),
+		},
+		data.ImportStep("identity_type"),
+	})
+}
+
+func TestAccSynapseWorkspaceAADAdmin_groupWithoutObjectId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_aad_admin", "test")
+	r := SynapseWorkspaceAADAdminResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.groupWithoutObjectId(data),
+			ExpectError: regexp.MustCompile("`object_id` must be specified when `identity_type` is \"Group\""),
+		},
+	})
+}
+
+func TestAccSynapseWorkspaceAADAdmin_objectIdFromLogin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_aad_admin", "test")
+	r := SynapseWorkspaceAADAdminResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.objectIdFromLogin(data, "azuread_user.test.user_principal_name"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				acceptance.TestCheckResourceAttrPair(data.ResourceName, "object_id", "azuread_user.test", "object_id"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.objectIdFromLogin(data, "azuread_user.other.user_principal_name"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				acceptance.TestCheckResourceAttrPair(data.ResourceName, "object_id", "azuread_user.other", "object_id"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.objectIdFromLogin(data, fmt.Sprintf(`"acctestsynapsemissing-%d@${data.azuread_domains.test.domains.0.domain_name}"`, data.RandomInteger)),
+			ExpectError: regexp.MustCompile("no Azure Active Directory user was found with the User Principal Name"),
+		},
+	})
+}
+
+func TestAccSynapseWorkspaceAADAdmin_enabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_aad_admin", "test")
+	r := SynapseWorkspaceAADAdminResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.disabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).DoesNotExistInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseWorkspaceAADAdmin_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_aad_admin", "test")
+	r := SynapseWorkspaceAADAdminResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		data.DisappearsStep(acceptance.DisappearsStepData{
+			Config:       r.basic,
+			TestResource: r,
+		}),
+	})
+}
+
 func (r SynapseWorkspaceAADAdminResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.WorkspaceAADAdminID(state.ID)
 	if err != nil {
@@ -44,6 +160,24 @@ func (r SynapseWorkspaceAADAdminResource) Exists(ctx context.Context, client *cl
 		return nil, fmt.Errorf("retrieving Synapse Workspace %q (Resource Group %q): %+v", id.WorkspaceName, id.ResourceGroup, err)
 	}
 
+	return utils.Bool(resp.AadAdminProperties != nil), nil
+}
+
+func (r SynapseWorkspaceAADAdminResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WorkspaceAADAdminID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	future, err := client.Synapse.WorkspaceAadAdminsClient.Delete(ctx, id.ResourceGroup, id.WorkspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("deleting Synapse Workspace %q AAD Admin (Resource Group %q): %+v", id.WorkspaceName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Synapse.WorkspaceAadAdminsClient.Client); err != nil {
+		return nil, fmt.Errorf("waiting for deletion of Synapse Workspace %q AAD Admin (Resource Group %q): %+v", id.WorkspaceName, id.ResourceGroup, err)
+	}
+
 	return utils.Bool(true), nil
 }
 
@@ -62,6 +196,108 @@ resource "azurerm_synapse_workspace_aad_admin" "test" {
 `, template)
 }
 
+func (r SynapseWorkspaceAADAdminResource) disabled(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_synapse_workspace_aad_admin" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  login                = "AzureAD Admin"
+  object_id            = data.azurerm_client_config.current.object_id
+  tenant_id            = data.azurerm_client_config.current.tenant_id
+  enabled              = false
+}
+`, template)
+}
+
+func (r SynapseWorkspaceAADAdminResource) servicePrincipal(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_synapse_workspace_aad_admin" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  login                = "AzureAD Admin"
+  object_id            = data.azurerm_client_config.current.object_id
+  tenant_id            = data.azurerm_client_config.current.tenant_id
+  identity_type        = "ServicePrincipal"
+}
+`, template)
+}
+
+func (r SynapseWorkspaceAADAdminResource) group(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+provider "azuread" {}
+
+data "azurerm_client_config" "current" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestsynapseadmins-%d"
+  security_enabled = true
+}
+
+resource "azurerm_synapse_workspace_aad_admin" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  login                = azuread_group.test.display_name
+  object_id            = azuread_group.test.object_id
+  tenant_id            = data.azurerm_client_config.current.tenant_id
+  identity_type        = "Group"
+}
+`, template, data.RandomInteger)
+}
+
+func (r SynapseWorkspaceAADAdminResource) groupWithoutObjectId(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_synapse_workspace_aad_admin" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  login                = "acctestsynapseadmins-%d"
+  tenant_id            = data.azurerm_client_config.current.tenant_id
+  identity_type        = "Group"
+}
+`, template, data.RandomInteger)
+}
+
+func (r SynapseWorkspaceAADAdminResource) objectIdFromLogin(data acceptance.TestData, loginExpression string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%[1]s
+provider "azuread" {}
+
+data "azurerm_client_config" "current" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestsynapseadmin-%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestsynapseadmin-%[2]d"
+  password            = "p@$$Wd%[3]s"
+}
+
+resource "azuread_user" "other" {
+  user_principal_name = "acctestsynapseother-%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestsynapseother-%[2]d"
+  password            = "p@$$Wd%[3]s"
+}
+
+resource "azurerm_synapse_workspace_aad_admin" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  login                = %[4]s
+  tenant_id            = data.azurerm_client_config.current.tenant_id
+}
+`, template, data.RandomInteger, data.RandomString, loginExpression)
+}
+
 func (r SynapseWorkspaceAADAdminResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
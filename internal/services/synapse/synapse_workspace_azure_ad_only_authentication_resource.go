@@ -0,0 +1,179 @@
+package synapse
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SynapseWorkspaceAzureADOnlyAuthenticationResource struct{}
+
+var _ sdk.ResourceWithUpdate = SynapseWorkspaceAzureADOnlyAuthenticationResource{}
+
+type SynapseWorkspaceAzureADOnlyAuthenticationModel struct {
+	SynapseWorkspaceId string `tfschema:"synapse_workspace_id"`
+	Enabled            bool   `tfschema:"enabled"`
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"synapse_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WorkspaceID,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+	}
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) ResourceType() string {
+	return "azurerm_synapse_workspace_azure_ad_only_authentication"
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) ModelObject() interface{} {
+	return &SynapseWorkspaceAzureADOnlyAuthenticationModel{}
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WorkspaceAzureADOnlyAuthenticationID
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SynapseWorkspaceAzureADOnlyAuthenticationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := parse.WorkspaceID(model.SynapseWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWorkspaceAzureADOnlyAuthenticationID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, "default")
+
+			if err := r.set(ctx, metadata, id, model.Enabled); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Synapse.AzureADOnlyAuthenticationsClient
+
+			id, err := parse.WorkspaceAzureADOnlyAuthenticationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := SynapseWorkspaceAzureADOnlyAuthenticationModel{
+				SynapseWorkspaceId: parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+			}
+			if props := resp.AzureADOnlyAuthenticationProperties; props != nil {
+				state.Enabled = utils.NormaliseNilableBool(props.AzureADOnlyAuthentication)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.WorkspaceAzureADOnlyAuthenticationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SynapseWorkspaceAzureADOnlyAuthenticationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return r.set(ctx, metadata, *id, model.Enabled)
+		},
+	}
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.WorkspaceAzureADOnlyAuthenticationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// this setting can't be removed, so it's reset back to the default, allowing SQL Authentication again
+			return r.set(ctx, metadata, *id, false)
+		},
+	}
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) set(ctx context.Context, metadata sdk.ResourceMetaData, id parse.WorkspaceAzureADOnlyAuthenticationId, enabled bool) error {
+	client := metadata.Client.Synapse.AzureADOnlyAuthenticationsClient
+
+	if enabled {
+		// Azure rejects enabling Azure AD Only Authentication until an Azure AD Admin has been configured on the Workspace
+		aadAdminClient := metadata.Client.Synapse.WorkspaceAadAdminsClient
+		aadAdmin, err := aadAdminClient.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+		if err != nil && !utils.ResponseWasNotFound(aadAdmin.Response) {
+			return fmt.Errorf("retrieving AAD Admin for Synapse Workspace %q (Resource Group %q): %+v", id.WorkspaceName, id.ResourceGroup, err)
+		}
+		if utils.ResponseWasNotFound(aadAdmin.Response) || aadAdmin.AadAdminProperties == nil || aadAdmin.AadAdminProperties.Sid == nil {
+			return fmt.Errorf("Azure AD Only Authentication can only be enabled once an AAD Admin has been configured for Synapse Workspace %q (Resource Group %q) - configure one using the `azurerm_synapse_workspace_aad_admin` resource and reference it using `depends_on`", id.WorkspaceName, id.ResourceGroup)
+		}
+	}
+
+	payload := synapse.AzureADOnlyAuthentication{
+		AzureADOnlyAuthenticationProperties: &synapse.AzureADOnlyAuthenticationProperties{
+			AzureADOnlyAuthentication: utils.Bool(enabled),
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.WorkspaceName, payload)
+	if err != nil {
+		return fmt.Errorf("setting `enabled` to %t for %s: %+v", enabled, id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for `enabled` to be set to %t for %s: %+v", enabled, id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SynapseWorkspaceAzureADOnlyAuthenticationResource struct{}
+
+func TestAccSynapseWorkspaceAzureADOnlyAuthentication_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_azure_ad_only_authentication", "test")
+	r := SynapseWorkspaceAzureADOnlyAuthenticationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseWorkspaceAzureADOnlyAuthentication_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_azure_ad_only_authentication", "test")
+	r := SynapseWorkspaceAzureADOnlyAuthenticationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseWorkspaceAzureADOnlyAuthentication_withoutAADAdmin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_azure_ad_only_authentication", "test")
+	r := SynapseWorkspaceAzureADOnlyAuthenticationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.withoutAADAdmin(data),
+			ExpectError: regexp.MustCompile("can only be enabled once an AAD Admin has been configured"),
+		},
+	})
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WorkspaceAzureADOnlyAuthenticationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Synapse.AzureADOnlyAuthenticationsClient.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.AzureADOnlyAuthenticationProperties != nil), nil
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) basic(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_workspace_azure_ad_only_authentication" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  enabled              = %t
+
+  depends_on = [
+    azurerm_synapse_workspace_aad_admin.test,
+  ]
+}
+`, SynapseWorkspaceAADAdminResource{}.basic(data), enabled)
+}
+
+func (r SynapseWorkspaceAzureADOnlyAuthenticationResource) withoutAADAdmin(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_workspace_azure_ad_only_authentication" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  enabled              = true
+}
+`, SynapseWorkspaceAADAdminResource{}.template(data))
+}
@@ -30,13 +30,29 @@ func TestAccSynapseWorkspaceSqlAADAdmin_basic(t *testing.T) {
 	})
 }
 
+func TestAccSynapseWorkspaceSqlAADAdmin_withWorkspaceAADAdmin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_sql_aad_admin", "test")
+	r := SynapseWorkspaceSqlAADAdminResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withWorkspaceAADAdmin(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_synapse_workspace_aad_admin.test").ExistsInAzure(SynapseWorkspaceAADAdminResource{}),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r SynapseWorkspaceSqlAADAdminResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.WorkspaceSqlAADAdminID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := client.Synapse.WorkspaceAadAdminsClient.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+	resp, err := client.Synapse.WorkspaceSQLAadAdminsClient.Get(ctx, id.ResourceGroup, id.WorkspaceName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -62,6 +78,19 @@ resource "azurerm_synapse_workspace_sql_aad_admin" "test" {
 `, template)
 }
 
+func (r SynapseWorkspaceSqlAADAdminResource) withWorkspaceAADAdmin(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_workspace_aad_admin" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  login                = "AzureAD Admin"
+  object_id            = data.azurerm_client_config.current.object_id
+  tenant_id            = data.azurerm_client_config.current.tenant_id
+}
+`, r.basic(data))
+}
+
 func (r SynapseWorkspaceSqlAADAdminResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {